@@ -1,7 +1,15 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"unicode/utf8"
 
 	"github.com/PuerkitoBio/goquery"
@@ -10,25 +18,365 @@ import (
 	"golang.org/x/net/html"
 )
 
+// streamFlushTags are the block-level tags that, once their closing tag is
+// seen at the top of the document, are converted and flushed to the output
+// writer immediately instead of waiting for the rest of the document.
+var streamFlushTags = map[string]bool{
+	"p": true, "li": true, "pre": true, "tr": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+}
+
+// ConvertOptions controls how a single request is converted. The zero value
+// matches the service's long-standing defaults (GitHub-flavored commonmark,
+// no tag filtering, images included).
+type ConvertOptions struct {
+	// HeadingStyle is "atx" or "setext". Default: "atx".
+	HeadingStyle string
+	// CodeBlockStyle is "fenced" or "indented". Default: "fenced".
+	CodeBlockStyle string
+	// Fence is "```" or "~~~". Default: "```".
+	Fence string
+	// BulletListMarker is "-", "+", or "*". Default: "-".
+	BulletListMarker string
+	// LinkStyle is "inlined" or "referenced". Default: "inlined".
+	LinkStyle string
+	// EmDelimiter is "_" or "*". Default: "_".
+	EmDelimiter string
+	// StrongDelimiter is "**" or "__". Default: "**".
+	StrongDelimiter string
+
+	// StripTags removes matching tags (and their content) from the source
+	// before conversion, on top of the defaults (script, style, textarea).
+	StripTags []string
+	// KeepTags keeps matching tags as literal HTML in the markdown output
+	// instead of converting them.
+	KeepTags []string
+
+	// AbsoluteBaseURL resolves relative href/src attributes against this
+	// base URL. Empty means relative URLs are left untouched.
+	AbsoluteBaseURL string
+
+	// IncludeImages controls whether <img> tags are rendered at all.
+	// Default: true.
+	IncludeImages *bool
+	// ImageAsAltText renders <img> as its alt text instead of an image
+	// link, for callers that don't want binary/data-URI payloads inlined.
+	ImageAsAltText bool
+
+	// Mode selects the pre-processing stage run before conversion:
+	// ModeFull (default), ModeSanitized, or ModeReadability.
+	Mode string
+}
+
+// includeImages reports whether images should be rendered, defaulting to
+// true when the caller didn't set IncludeImages.
+func (o ConvertOptions) includeImages() bool {
+	return o.IncludeImages == nil || *o.IncludeImages
+}
+
 // Converter handles HTML to Markdown conversion
 type Converter struct {
-	converter *md.Converter
+	workers int
 }
 
-// NewConverter creates a new Converter instance with pre-configured rules
+// NewConverter creates a new Converter instance.
 func NewConverter() *Converter {
-	converter := md.NewConverter("", true, nil)
-	converter.Use(plugin.GitHubFlavored())
-	addGenericPreRule(converter)
-
 	return &Converter{
-		converter: converter,
+		workers: workersFromEnv(),
 	}
 }
 
-// ConvertHTMLToMarkdown converts HTML string to Markdown
+// build returns a *md.Converter configured for opts. A fresh converter is
+// built on every call rather than cached: opts (and therefore the cache key)
+// is caller-controlled, including arbitrary StripTags/KeepTags values, so
+// caching here would let any client of /convert, /convert/batch, or the gRPC
+// API grow the cache without bound. *md.Converter is cheap enough to build
+// that this isn't a meaningful cost per request.
+func (c *Converter) build(opts ConvertOptions) *md.Converter {
+	conv := md.NewConverter(opts.AbsoluteBaseURL, true, &md.Options{
+		HeadingStyle:     opts.HeadingStyle,
+		CodeBlockStyle:   opts.CodeBlockStyle,
+		Fence:            opts.Fence,
+		BulletListMarker: opts.BulletListMarker,
+		LinkStyle:        opts.LinkStyle,
+		EmDelimiter:      opts.EmDelimiter,
+		StrongDelimiter:  opts.StrongDelimiter,
+	})
+	conv.Use(plugin.GitHubFlavored())
+	addGenericPreRule(conv)
+
+	if len(opts.StripTags) > 0 {
+		conv.Remove(opts.StripTags...)
+	}
+	if len(opts.KeepTags) > 0 {
+		conv.Keep(opts.KeepTags...)
+	}
+	addImageRule(conv, opts)
+
+	return conv
+}
+
+// addImageRule wires up how <img> tags are rendered according to
+// opts.IncludeImages / opts.ImageAsAltText, overriding the commonmark default
+// image rule when the caller wants something other than a normal image link.
+func addImageRule(conv *md.Converter, opts ConvertOptions) {
+	if opts.includeImages() && !opts.ImageAsAltText {
+		return
+	}
+
+	conv.AddRules(md.Rule{
+		Filter: []string{"img"},
+		Replacement: func(_ string, selec *goquery.Selection, _ *md.Options) *string {
+			if !opts.includeImages() {
+				return md.String("")
+			}
+			return md.String(selec.AttrOr("alt", ""))
+		},
+	})
+}
+
+// workersFromEnv reads the CONVERT_WORKERS environment variable used to size
+// the batch conversion worker pool, falling back to runtime.NumCPU().
+func workersFromEnv() int {
+	if v := os.Getenv("CONVERT_WORKERS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+	}
+	return runtime.NumCPU()
+}
+
+// BatchItem is a single unit of work for ConvertBatch, identified by an
+// opaque caller-provided ID so results can be matched back to the request
+// that produced them.
+type BatchItem struct {
+	ID      string
+	HTML    string
+	Options ConvertOptions
+}
+
+// BatchResult is the outcome of converting a single BatchItem. Exactly one
+// of Markdown or Error is populated, mirroring ConvertResponse/ErrorResponse.
+type BatchResult struct {
+	ID       string
+	Success  bool
+	Markdown string
+	Error    string
+	Duration time.Duration
+}
+
+// ConvertBatch converts items concurrently using a bounded worker pool sized
+// by Converter.workers (see CONVERT_WORKERS), preserving the input order in
+// the returned slice. It stops handing out new work once ctx is done, and
+// any item that never got a chance to run is reported as failed with ctx's
+// error.
+//
+// If observe is non-nil, it's called once per item, synchronously, as soon
+// as that item finishes — from whichever worker goroutine processed it, so
+// observe must be safe for concurrent use. This lets the HTTP/gRPC layer
+// emit per-item metrics/tracing at the moment of actual work without
+// converter.go depending on those packages.
+func (c *Converter) ConvertBatch(ctx context.Context, items []BatchItem, observe func(BatchItem, BatchResult)) []BatchResult {
+	results := make([]BatchResult, len(items))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	workers := c.workers
+	if workers <= 0 {
+		workers = 1
+	}
+	if workers > len(items) {
+		workers = len(items)
+	}
+
+	report := func(i int, result BatchResult) {
+		results[i] = result
+		if observe != nil {
+			observe(items[i], result)
+		}
+	}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				report(i, c.convertBatchItemSafe(ctx, items[i]))
+			}
+		}()
+	}
+
+	for i := range items {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			report(i, BatchResult{ID: items[i].ID, Success: false, Error: ctx.Err().Error()})
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// convertBatchItemSafe runs convertBatchItem with panic recovery, so that
+// malformed input tripping a bug deep in a conversion library (regex on
+// adversarial input, a slice OOB in a rule, etc.) fails just that item
+// instead of taking down the worker goroutine — and, since these goroutines
+// aren't covered by net/http's per-request recover, the whole process.
+func (c *Converter) convertBatchItemSafe(ctx context.Context, item BatchItem) (result BatchResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = BatchResult{ID: item.ID, Success: false, Error: fmt.Sprintf("panic converting item: %v", r)}
+		}
+	}()
+	return c.convertBatchItem(ctx, item)
+}
+
+func (c *Converter) convertBatchItem(ctx context.Context, item BatchItem) BatchResult {
+	if err := ctx.Err(); err != nil {
+		return BatchResult{ID: item.ID, Success: false, Error: err.Error()}
+	}
+
+	start := time.Now()
+	markdown, err := c.ConvertHTMLToMarkdownWithOptions(item.HTML, item.Options)
+	duration := time.Since(start)
+	if err != nil {
+		return BatchResult{ID: item.ID, Success: false, Error: err.Error(), Duration: duration}
+	}
+	return BatchResult{ID: item.ID, Success: true, Markdown: markdown, Duration: duration}
+}
+
+// ConvertHTMLToMarkdown converts HTML string to Markdown using the default
+// ConvertOptions.
 func (c *Converter) ConvertHTMLToMarkdown(html string) (string, error) {
-	return c.converter.ConvertString(html)
+	return c.ConvertHTMLToMarkdownWithOptions(html, ConvertOptions{})
+}
+
+// ConvertHTMLToMarkdownWithOptions converts HTML string to Markdown using a
+// *md.Converter built fresh for the given options. When opts.Mode is
+// ModeSanitized or ModeReadability, the HTML is run through preprocessHTML
+// first so only the sanitized (or main-article) subtree is converted.
+func (c *Converter) ConvertHTMLToMarkdownWithOptions(html string, opts ConvertOptions) (string, error) {
+	html, err := preprocessHTML(html, opts.Mode)
+	if err != nil {
+		return "", err
+	}
+	return c.build(opts).ConvertString(html)
+}
+
+// ConvertHTMLToMarkdownStream tokenizes r incrementally and writes Markdown to
+// w as soon as each block-level subtree (p, li, pre, headings, table rows)
+// closes, instead of buffering the full document like ConvertHTMLToMarkdown
+// does. If w implements http.Flusher-compatible flushing (see flushWriter in
+// handler.go), the caller controls how often partial output is pushed to
+// the client. opts is honored the same way ConvertHTMLToMarkdownWithOptions
+// honors it (heading style, tag stripping, base URL resolution, ...).
+//
+// A flush tag's closing tag flushes as soon as none of its remaining
+// ancestors (e.g. the enclosing <html><body><div> of a typical crawled
+// page) is itself a flush tag, so wrapper elements that never close until
+// EOF don't defeat the whole point of streaming. A flush tag nested inside
+// another flush tag (e.g. a <li> inside a <ul> inside a <li>) is flushed as
+// part of its nearest flush-tag ancestor instead.
+//
+// opts.Mode of ModeSanitized or ModeReadability requires the whole document
+// up front (readability extraction needs the full DOM to find the main
+// article), so in that case r is buffered and preprocessed before
+// tokenizing — those modes trade incremental delivery for the extraction
+// they exist to do. ModeFull (the default) keeps tokenizing r as it arrives.
+func (c *Converter) ConvertHTMLToMarkdownStream(r io.Reader, w io.Writer, opts ConvertOptions) error {
+	conv := c.build(opts)
+
+	if opts.Mode == ModeSanitized || opts.Mode == ModeReadability {
+		body, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		preprocessed, err := preprocessHTML(string(body), opts.Mode)
+		if err != nil {
+			return err
+		}
+		r = strings.NewReader(preprocessed)
+	}
+
+	tokenizer := html.NewTokenizer(r)
+
+	var pending strings.Builder
+	var stack []string
+
+	flush := func() error {
+		if pending.Len() == 0 {
+			return nil
+		}
+		markdown, err := conv.ConvertString(pending.String())
+		pending.Reset()
+		if err != nil {
+			return err
+		}
+		markdown = strings.TrimRight(markdown, "\n")
+		if markdown == "" {
+			return nil
+		}
+		_, err = io.WriteString(w, markdown+"\n\n")
+		return err
+	}
+
+	for {
+		tt := tokenizer.Next()
+		switch tt {
+		case html.ErrorToken:
+			if err := tokenizer.Err(); err != io.EOF {
+				return err
+			}
+			return flush()
+		case html.StartTagToken:
+			name, _ := tokenizer.TagName()
+			tag := string(name)
+			if !isVoidElement(tag) {
+				stack = append(stack, tag)
+			}
+			pending.Write(tokenizer.Raw())
+		case html.EndTagToken:
+			pending.Write(tokenizer.Raw())
+			name, _ := tokenizer.TagName()
+			tag := string(name)
+			if len(stack) > 0 && stack[len(stack)-1] == tag {
+				stack = stack[:len(stack)-1]
+			}
+			if streamFlushTags[tag] && !hasFlushAncestor(stack) {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		default:
+			pending.Write(tokenizer.Raw())
+		}
+	}
+}
+
+// hasFlushAncestor reports whether any tag in stack is a streamFlushTags
+// entry, used to defer flushing a flush tag to its nearest flush-tag
+// ancestor instead of to the document root.
+func hasFlushAncestor(stack []string) bool {
+	for _, tag := range stack {
+		if streamFlushTags[tag] {
+			return true
+		}
+	}
+	return false
+}
+
+// isVoidElement reports whether tag is a self-closing HTML element that
+// never receives a matching end tag (e.g. <br>, <img>).
+func isVoidElement(tag string) bool {
+	switch tag {
+	case "area", "base", "br", "col", "embed", "hr", "img", "input",
+		"link", "meta", "param", "source", "track", "wbr":
+		return true
+	}
+	return false
 }
 
 // addGenericPreRule adds a robust PRE handler that extracts nested code text
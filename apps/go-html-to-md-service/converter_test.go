@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// countingWriter counts how many times Write is called, so tests can assert
+// on incremental flush behavior without caring about exact chunk boundaries.
+type countingWriter struct {
+	writes int
+	out    strings.Builder
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	return w.out.Write(p)
+}
+
+func TestConvertHTMLToMarkdownStream_FlushesInsideWrapperElements(t *testing.T) {
+	converter := NewConverter()
+
+	// A typical crawled page: everything is wrapped in <html><body><div>,
+	// none of which are flush tags and none of which close before EOF.
+	html := `<html><body><div>` +
+		`<p>Para one</p><p>Para two</p><p>Para three</p>` +
+		`</div></body></html>`
+
+	w := &countingWriter{}
+	if err := converter.ConvertHTMLToMarkdownStream(strings.NewReader(html), w, ConvertOptions{}); err != nil {
+		t.Fatalf("ConvertHTMLToMarkdownStream failed: %v", err)
+	}
+
+	if w.writes < 3 {
+		t.Errorf("expected a flush per paragraph despite the enclosing wrapper, got %d writes", w.writes)
+	}
+
+	out := w.out.String()
+	for _, want := range []string{"Para one", "Para two", "Para three"} {
+		if !contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestConvertHTMLToMarkdownStream_NestedListFlushesOnce(t *testing.T) {
+	converter := NewConverter()
+
+	// A <li> nested inside another <li> (via an intervening <ul>) should
+	// flush as part of its nearest flush-tag ancestor, not independently.
+	html := `<ul><li>Outer<ul><li>Inner</li></ul></li></ul>`
+
+	w := &countingWriter{}
+	if err := converter.ConvertHTMLToMarkdownStream(strings.NewReader(html), w, ConvertOptions{}); err != nil {
+		t.Fatalf("ConvertHTMLToMarkdownStream failed: %v", err)
+	}
+
+	if w.writes != 1 {
+		t.Errorf("expected the nested list to flush as a single unit, got %d writes", w.writes)
+	}
+}
+
+func TestConvertHTMLToMarkdownStream_HonorsOptions(t *testing.T) {
+	converter := NewConverter()
+
+	html := `<p>Keep this</p><span class="ad">Strip this</span>`
+
+	w := &countingWriter{}
+	opts := ConvertOptions{StripTags: []string{"span"}}
+	if err := converter.ConvertHTMLToMarkdownStream(strings.NewReader(html), w, opts); err != nil {
+		t.Fatalf("ConvertHTMLToMarkdownStream failed: %v", err)
+	}
+
+	out := w.out.String()
+	if !contains(out, "Keep this") {
+		t.Errorf("expected output to contain %q, got %q", "Keep this", out)
+	}
+	if contains(out, "Strip this") {
+		t.Errorf("expected stripped <span> content to be absent, got %q", out)
+	}
+}
+
+func TestConvertBatch_ObserveCalledPerItem(t *testing.T) {
+	converter := NewConverter()
+
+	items := []BatchItem{
+		{ID: "a", HTML: "<p>One</p>"},
+		{ID: "b", HTML: "<p>Two</p>"},
+		{ID: "c", HTML: "<p>Three</p>"},
+	}
+
+	var mu sync.Mutex
+	observed := make(map[string]BatchResult)
+	observe := func(item BatchItem, result BatchResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		observed[item.ID] = result
+	}
+
+	results := converter.ConvertBatch(context.Background(), items, observe)
+
+	if len(results) != len(items) {
+		t.Fatalf("expected %d results, got %d", len(items), len(results))
+	}
+	if len(observed) != len(items) {
+		t.Fatalf("expected observe to be called once per item, got %d calls", len(observed))
+	}
+	for _, item := range items {
+		result, ok := observed[item.ID]
+		if !ok {
+			t.Errorf("observe was never called for item %q", item.ID)
+			continue
+		}
+		if !result.Success {
+			t.Errorf("item %q: expected success, got error %q", item.ID, result.Error)
+		}
+	}
+}
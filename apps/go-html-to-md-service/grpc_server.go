@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/firecrawl/go-html-to-md-service/proto"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcServer implements pb.ConvertServiceServer on top of the same Converter
+// instance the HTTP handler uses, so both transports share cached converters
+// and worker pool sizing.
+type grpcServer struct {
+	pb.UnimplementedConvertServiceServer
+	converter *Converter
+}
+
+// newGRPCServer creates a grpcServer backed by converter.
+func newGRPCServer(converter *Converter) *grpcServer {
+	return &grpcServer{converter: converter}
+}
+
+func (s *grpcServer) Convert(ctx context.Context, req *pb.ConvertRequest) (*pb.ConvertResponse, error) {
+	markdown, err := s.converter.ConvertHTMLToMarkdownWithOptions(req.GetHtml(), toConvertOptions(req.GetOptions()))
+	if err != nil {
+		logGRPCError("Convert", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.ConvertResponse{Markdown: markdown}, nil
+}
+
+func (s *grpcServer) ConvertStream(req *pb.ConvertRequest, stream pb.ConvertService_ConvertStreamServer) error {
+	w := &grpcChunkWriter{stream: stream}
+	if err := s.converter.ConvertHTMLToMarkdownStream(strings.NewReader(req.GetHtml()), w, toConvertOptions(req.GetOptions())); err != nil {
+		logGRPCError("ConvertStream", err)
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+func (s *grpcServer) ConvertBatch(ctx context.Context, req *pb.ConvertBatchRequest) (*pb.ConvertBatchResponse, error) {
+	items := make([]BatchItem, len(req.GetItems()))
+	for i, item := range req.GetItems() {
+		items[i] = BatchItem{ID: item.GetId(), HTML: item.GetHtml(), Options: toConvertOptions(item.GetOptions())}
+	}
+
+	results := s.converter.ConvertBatch(ctx, items, nil)
+
+	resp := &pb.ConvertBatchResponse{Results: make([]*pb.ConvertBatchResult, len(results))}
+	for i, result := range results {
+		resp.Results[i] = &pb.ConvertBatchResult{
+			Id:         result.ID,
+			Markdown:   result.Markdown,
+			Error:      result.Error,
+			Success:    result.Success,
+			DurationMs: result.Duration.Milliseconds(),
+		}
+	}
+	return resp, nil
+}
+
+// toConvertOptions converts the gRPC wire-format options to the
+// ConvertOptions the Converter understands, mirroring RequestOptions'
+// toConvertOptions for the HTTP API.
+func toConvertOptions(o *pb.ConvertOptions) ConvertOptions {
+	if o == nil {
+		return ConvertOptions{}
+	}
+	return ConvertOptions{
+		HeadingStyle:     o.GetHeadingStyle(),
+		CodeBlockStyle:   o.GetCodeBlockStyle(),
+		Fence:            o.GetFence(),
+		BulletListMarker: o.GetBulletListMarker(),
+		LinkStyle:        o.GetLinkStyle(),
+		EmDelimiter:      o.GetEmDelimiter(),
+		StrongDelimiter:  o.GetStrongDelimiter(),
+		StripTags:        o.GetStripTags(),
+		KeepTags:         o.GetKeepTags(),
+		AbsoluteBaseURL:  o.GetAbsoluteBaseUrl(),
+		IncludeImages:    o.IncludeImages,
+		ImageAsAltText:   o.GetImageAsAltText(),
+		Mode:             o.GetMode(),
+	}
+}
+
+// grpcChunkWriter adapts the io.Writer ConvertHTMLToMarkdownStream expects
+// into a server-streaming RPC, sending each flushed block as a ConvertChunk.
+type grpcChunkWriter struct {
+	stream pb.ConvertService_ConvertStreamServer
+}
+
+func (w *grpcChunkWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&pb.ConvertChunk{Markdown: string(p)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// logGRPCError logs a failed unary or streaming RPC the same way the HTTP
+// handlers log conversion failures, so both transports show up in the same
+// place.
+func logGRPCError(method string, err error) {
+	log.Error().Err(err).Str("rpc", method).Msg("gRPC conversion request failed")
+}
@@ -1,13 +1,18 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
+	"github.com/yuin/goldmark"
 )
 
 const (
@@ -16,20 +21,29 @@ const (
 
 // Handler manages HTTP request handling
 type Handler struct {
-	converter *Converter
+	converter   *Converter
+	rateLimiter *ipRateLimiter
 }
 
 // NewHandler creates a new Handler instance
 func NewHandler(converter *Converter) *Handler {
 	return &Handler{
-		converter: converter,
+		converter:   converter,
+		rateLimiter: newIPRateLimiter(),
 	}
 }
 
-// RegisterRoutes registers all HTTP routes
+// RegisterRoutes registers all HTTP routes, wrapped in middleware that tags
+// each request with an X-Request-ID, enforces a per-IP rate limit, and opens
+// an OpenTelemetry span for tracing.
 func (h *Handler) RegisterRoutes(router *mux.Router) {
+	router.Use(requestIDMiddleware, rateLimitMiddleware(h.rateLimiter), tracingMiddleware)
+
 	router.HandleFunc("/health", h.HealthCheck).Methods("GET")
+	router.HandleFunc("/metrics", metricsHandler().ServeHTTP).Methods("GET")
 	router.HandleFunc("/convert", h.ConvertHTML).Methods("POST")
+	router.HandleFunc("/convert/stream", h.ConvertHTMLStream).Methods("POST")
+	router.HandleFunc("/convert/batch", h.ConvertBatch).Methods("POST")
 	router.HandleFunc("/", h.Index).Methods("GET")
 }
 
@@ -70,6 +84,8 @@ func (h *Handler) Index(w http.ResponseWriter, r *http.Request) {
 		Endpoints: []string{
 			"GET  /health - Health check endpoint",
 			"POST /convert - Convert HTML to Markdown",
+			"POST /convert/stream - Convert HTML to Markdown, streamed",
+			"POST /convert/batch - Convert a batch of HTML documents",
 		},
 	}
 
@@ -80,7 +96,80 @@ func (h *Handler) Index(w http.ResponseWriter, r *http.Request) {
 
 // ConvertRequest represents the conversion request payload
 type ConvertRequest struct {
-	HTML string `json:"html"`
+	HTML    string         `json:"html"`
+	Options RequestOptions `json:"options,omitempty"`
+}
+
+// RequestOptions is the JSON wire shape for per-request conversion options.
+// It mirrors ConvertOptions field-for-field and is converted via toConvertOptions.
+type RequestOptions struct {
+	HeadingStyle     string   `json:"heading_style,omitempty"`
+	CodeBlockStyle   string   `json:"code_block_style,omitempty"`
+	Fence            string   `json:"fence,omitempty"`
+	BulletListMarker string   `json:"bullet_list_marker,omitempty"`
+	LinkStyle        string   `json:"link_style,omitempty"`
+	EmDelimiter      string   `json:"em_delimiter,omitempty"`
+	StrongDelimiter  string   `json:"strong_delimiter,omitempty"`
+	StripTags        []string `json:"strip_tags,omitempty"`
+	KeepTags         []string `json:"keep_tags,omitempty"`
+	AbsoluteBaseURL  string   `json:"absolute_base_url,omitempty"`
+	IncludeImages    *bool    `json:"include_images,omitempty"`
+	ImageAsAltText   bool     `json:"image_as_alt_text,omitempty"`
+	// Mode is "readability", "full", or "sanitized". Also settable via the
+	// ?mode= query parameter on POST /convert, which takes precedence.
+	Mode string `json:"mode,omitempty"`
+}
+
+// optionsFromQuery builds RequestOptions from query parameters, for
+// ConvertHTMLStream where the request body is the raw HTML being streamed
+// and converted rather than a JSON envelope that could carry an "options"
+// object. Field names mirror RequestOptions' JSON tags; strip_tags and
+// keep_tags take a comma-separated list.
+func optionsFromQuery(r *http.Request) RequestOptions {
+	q := r.URL.Query()
+	opts := RequestOptions{
+		HeadingStyle:     q.Get("heading_style"),
+		CodeBlockStyle:   q.Get("code_block_style"),
+		Fence:            q.Get("fence"),
+		BulletListMarker: q.Get("bullet_list_marker"),
+		LinkStyle:        q.Get("link_style"),
+		EmDelimiter:      q.Get("em_delimiter"),
+		StrongDelimiter:  q.Get("strong_delimiter"),
+		AbsoluteBaseURL:  q.Get("absolute_base_url"),
+		ImageAsAltText:   q.Get("image_as_alt_text") == "true",
+		Mode:             q.Get("mode"),
+	}
+	if v := q.Get("strip_tags"); v != "" {
+		opts.StripTags = strings.Split(v, ",")
+	}
+	if v := q.Get("keep_tags"); v != "" {
+		opts.KeepTags = strings.Split(v, ",")
+	}
+	if v := q.Get("include_images"); v != "" {
+		include := v == "true"
+		opts.IncludeImages = &include
+	}
+	return opts
+}
+
+// toConvertOptions converts the request's wire-format options to the
+// ConvertOptions the Converter understands.
+func (o RequestOptions) toConvertOptions() ConvertOptions {
+	return ConvertOptions{
+		HeadingStyle:     o.HeadingStyle,
+		CodeBlockStyle:   o.CodeBlockStyle,
+		Fence:            o.Fence,
+		BulletListMarker: o.BulletListMarker,
+		LinkStyle:        o.LinkStyle,
+		EmDelimiter:      o.EmDelimiter,
+		StrongDelimiter:  o.StrongDelimiter,
+		StripTags:        o.StripTags,
+		KeepTags:         o.KeepTags,
+		AbsoluteBaseURL:  o.AbsoluteBaseURL,
+		IncludeImages:    o.IncludeImages,
+		ImageAsAltText:   o.ImageAsAltText,
+		Mode:             o.Mode,
+	}
 }
 
 // ConvertResponse represents the conversion response payload
@@ -99,18 +188,12 @@ type ErrorResponse struct {
 // ConvertHTML handles HTML to Markdown conversion requests
 func (h *Handler) ConvertHTML(w http.ResponseWriter, r *http.Request) {
 	startTime := time.Now()
-
-	// Extract request ID from header for logging
-	requestID := r.Header.Get("X-Request-ID")
-	logger := log.Logger
-	if requestID != "" {
-		logger = log.With().Str("request_id", requestID).Logger()
-	}
+	logger := loggerFromRequest(r)
 
 	// Limit request body size
 	r.Body = http.MaxBytesReader(w, r.Body, maxRequestSize)
 
-	// Read and decode request body
+	// Read request body
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to read request body")
@@ -118,8 +201,13 @@ func (h *Handler) ConvertHTML(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// A raw text/html body skips the JSON envelope entirely, which matters
+	// for the 150MB upload path where JSON escaping would otherwise double
+	// memory pressure.
 	var req ConvertRequest
-	if err := json.Unmarshal(body, &req); err != nil {
+	if isContentType(r, "text/html") {
+		req.HTML = string(body)
+	} else if err := json.Unmarshal(body, &req); err != nil {
 		logger.Error().Err(err).Msg("Failed to parse request body")
 		h.sendError(w, "Invalid JSON in request body", err.Error(), http.StatusBadRequest)
 		return
@@ -132,8 +220,21 @@ func (h *Handler) ConvertHTML(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Convert HTML to Markdown
-	markdown, err := h.converter.ConvertHTMLToMarkdown(req.HTML)
+	// The ?mode= query parameter takes precedence over options.mode in the body.
+	if mode := r.URL.Query().Get("mode"); mode != "" {
+		req.Options.Mode = mode
+	}
+
+	// Convert HTML to Markdown, timing just the conversion itself (not the
+	// body read/JSON parse above) for the span and metrics.
+	convertInflight.Inc()
+	convertStart := time.Now()
+	markdown, err := h.converter.ConvertHTMLToMarkdownWithOptions(req.HTML, req.Options.toConvertOptions())
+	convertDuration := time.Since(convertStart)
+	convertInflight.Dec()
+
+	recordConversion(r.Context(), "ConvertHTMLToMarkdown", len(req.HTML), countDOMNodes(req.HTML), convertStart, convertDuration, markdown, len(markdown), err)
+
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to convert HTML to Markdown")
 		h.sendError(w, "Failed to convert HTML to Markdown", err.Error(), http.StatusInternalServerError)
@@ -141,17 +242,188 @@ func (h *Handler) ConvertHTML(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Log metrics
-	duration := time.Since(startTime)
 	logger.Info().
-		Dur("duration_ms", duration).
+		Dur("duration_ms", time.Since(startTime)).
 		Int("input_size", len(req.HTML)).
 		Int("output_size", len(markdown)).
 		Msg("HTML to Markdown conversion completed")
 
-	// Send response
-	response := ConvertResponse{
-		Markdown: markdown,
-		Success:  true,
+	h.writeConvertResult(w, r, markdown, logger)
+}
+
+// writeConvertResult sends the converted markdown in the format requested
+// via the Accept header: the raw markdown body for "text/markdown", the
+// markdown rendered back to sanitized HTML for "text/html", and the default
+// JSON envelope otherwise.
+func (h *Handler) writeConvertResult(w http.ResponseWriter, r *http.Request, markdown string, logger zerolog.Logger) {
+	switch {
+	case acceptsContentType(r, "text/markdown"):
+		w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		io.WriteString(w, markdown)
+	case acceptsContentType(r, "text/html"):
+		sanitized, err := markdownToSanitizedHTML(markdown)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to render markdown back to HTML")
+			h.sendError(w, "Failed to render markdown to HTML", err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		io.WriteString(w, sanitized)
+	default:
+		response := ConvertResponse{
+			Markdown: markdown,
+			Success:  true,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	}
+}
+
+// isContentType reports whether the request's Content-Type header matches
+// mediaType, ignoring any parameters (e.g. charset).
+func isContentType(r *http.Request, mediaType string) bool {
+	return mediaTypeOf(r.Header.Get("Content-Type")) == mediaType
+}
+
+// acceptsContentType reports whether the request's Accept header names
+// mediaType, ignoring any parameters (e.g. q-values).
+func acceptsContentType(r *http.Request, mediaType string) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		if mediaTypeOf(part) == mediaType {
+			return true
+		}
+	}
+	return false
+}
+
+// mediaTypeOf strips parameters (anything after ';') and surrounding
+// whitespace from a Content-Type/Accept header value.
+func mediaTypeOf(headerValue string) string {
+	mediaType, _, _ := strings.Cut(headerValue, ";")
+	return strings.TrimSpace(mediaType)
+}
+
+// markdownToSanitizedHTML renders markdown back to HTML for Accept:
+// text/html responses, round-tripping the original document through the
+// converter's sanitization/GFM rules rather than echoing raw input back.
+func markdownToSanitizedHTML(markdown string) (string, error) {
+	var buf bytes.Buffer
+	if err := goldmark.Convert([]byte(markdown), &buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// BatchConvertRequest represents the batch conversion request payload
+type BatchConvertRequest struct {
+	Items []BatchConvertItem `json:"items"`
+}
+
+// BatchConvertItem is a single HTML document to convert as part of a batch.
+type BatchConvertItem struct {
+	ID      string         `json:"id"`
+	HTML    string         `json:"html"`
+	Options RequestOptions `json:"options,omitempty"`
+}
+
+// BatchConvertResultItem is the per-item result returned by ConvertBatch, in
+// the same order as the request's Items.
+type BatchConvertResultItem struct {
+	ID         string `json:"id"`
+	Success    bool   `json:"success"`
+	Markdown   string `json:"markdown,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+// defaultBatchTimeout bounds how long a batch request runs when the caller
+// does not supply X-Request-Timeout.
+const defaultBatchTimeout = 1 * time.Minute
+
+// ConvertBatch handles POST /convert/batch: it converts each item in
+// req.Items concurrently through a bounded worker pool (see
+// Converter.ConvertBatch / CONVERT_WORKERS) and returns per-item results
+// preserving input order. The overall deadline is taken from the
+// X-Request-Timeout header (a Go duration string, e.g. "30s"), falling back
+// to defaultBatchTimeout.
+func (h *Handler) ConvertBatch(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+	logger := loggerFromRequest(r)
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to read request body")
+		h.sendError(w, "Failed to read request body", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var req BatchConvertRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		logger.Error().Err(err).Msg("Failed to parse request body")
+		h.sendError(w, "Invalid JSON in request body", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if len(req.Items) == 0 {
+		logger.Warn().Msg("Empty items array in batch request")
+		h.sendError(w, "items field is required", "The 'items' array cannot be empty", http.StatusBadRequest)
+		return
+	}
+
+	timeout := defaultBatchTimeout
+	if v := r.Header.Get("X-Request-Timeout"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			timeout = d
+		}
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+
+	items := make([]BatchItem, len(req.Items))
+	for i, reqItem := range req.Items {
+		items[i] = BatchItem{ID: reqItem.ID, HTML: reqItem.HTML, Options: reqItem.Options.toConvertOptions()}
+	}
+
+	// Record metrics/tracing for each item as it finishes, not just the
+	// batch as a whole, so per-item dashboards see batch traffic too.
+	observe := func(item BatchItem, res BatchResult) {
+		var convertErr error
+		if !res.Success {
+			convertErr = errors.New(res.Error)
+		}
+		end := time.Now()
+		recordConversion(r.Context(), "ConvertHTMLToMarkdown", len(item.HTML), countDOMNodes(item.HTML), end.Add(-res.Duration), res.Duration, res.Markdown, len(res.Markdown), convertErr)
+	}
+
+	results := h.converter.ConvertBatch(ctx, items, observe)
+
+	response := make([]BatchConvertResultItem, len(results))
+	for i, res := range results {
+		response[i] = BatchConvertResultItem{
+			ID:         res.ID,
+			Success:    res.Success,
+			Markdown:   res.Markdown,
+			Error:      res.Error,
+			DurationMS: res.Duration.Milliseconds(),
+		}
+	}
+
+	logger.Info().
+		Dur("duration_ms", time.Since(startTime)).
+		Int("item_count", len(items)).
+		Msg("Batch HTML to Markdown conversion completed")
+
+	if acceptsContentType(r, "application/x-ndjson") {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.WriteHeader(http.StatusOK)
+		enc := json.NewEncoder(w)
+		for _, item := range response {
+			enc.Encode(item)
+		}
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -159,6 +431,89 @@ func (h *Handler) ConvertHTML(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// ConvertHTMLStream handles streamed HTML to Markdown conversion. Unlike
+// ConvertHTML it never buffers the full request body: the HTML is tokenized
+// as it arrives and finished block-level subtrees are written to the
+// response as soon as they close, using HTTP chunked transfer encoding.
+// Conversion failures that occur after the response has already started are
+// reported via the X-Conversion-Error trailer rather than a status code.
+//
+// The request body is the raw HTML to convert, so per-request options (the
+// same ones ConvertHTML/ConvertBatch accept in their JSON body) are read
+// from query parameters instead — see optionsFromQuery. A mode=readability
+// or mode=sanitized query value buffers the whole body before converting
+// (see ConvertHTMLToMarkdownStream), trading incremental delivery for the
+// full-document extraction those modes require.
+func (h *Handler) ConvertHTMLStream(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+	logger := loggerFromRequest(r)
+	opts := optionsFromQuery(r).toConvertOptions()
+
+	w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+	w.Header().Set("Trailer", "X-Conversion-Error")
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	fw := &flushWriter{w: w, f: flusher}
+	cr := &countingReader{r: r.Body}
+
+	convertInflight.Inc()
+	convertStart := time.Now()
+	err := h.converter.ConvertHTMLToMarkdownStream(cr, fw, opts)
+	convertDuration := time.Since(convertStart)
+	convertInflight.Dec()
+
+	// Streaming never buffers the full document or output, so unlike
+	// ConvertHTML/ConvertBatch there's no markdown string to detect
+	// code-block languages from and no DOM to count nodes in (domNodeCount
+	// 0 means "unknown", not zero).
+	recordConversion(r.Context(), "ConvertHTMLToMarkdownStream", int(cr.read), 0, convertStart, convertDuration, "", int(fw.written), err)
+
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to stream-convert HTML to Markdown")
+		w.Header().Set("X-Conversion-Error", err.Error())
+		return
+	}
+
+	logger.Info().
+		Dur("duration_ms", time.Since(startTime)).
+		Int64("output_size", fw.written).
+		Msg("Streamed HTML to Markdown conversion completed")
+}
+
+// countingReader wraps an io.Reader to track bytes read, sizing the
+// convert_input_bytes metric for the streaming endpoint without buffering
+// the request body (which would defeat the point of streaming).
+type countingReader struct {
+	r    io.Reader
+	read int64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.read += int64(n)
+	return n, err
+}
+
+// flushWriter wraps an http.ResponseWriter so that every write is flushed to
+// the client immediately, giving back-pressure-aware callers first-byte
+// latency proportional to the first converted block rather than the whole
+// response.
+type flushWriter struct {
+	w       io.Writer
+	f       http.Flusher
+	written int64
+}
+
+func (fw *flushWriter) Write(p []byte) (int, error) {
+	n, err := fw.w.Write(p)
+	fw.written += int64(n)
+	if err == nil && fw.f != nil {
+		fw.f.Flush()
+	}
+	return n, err
+}
+
 // sendError sends an error response
 func (h *Handler) sendError(w http.ResponseWriter, message string, details string, statusCode int) {
 	response := ErrorResponse{
@@ -254,6 +254,288 @@ func TestConverter_ComplexHTML(t *testing.T) {
 	}
 }
 
+func TestConvertHTML_RawHTMLBody(t *testing.T) {
+	converter := NewConverter()
+	handler := NewHandler(converter)
+
+	router := mux.NewRouter()
+	handler.RegisterRoutes(router)
+
+	req, err := http.NewRequest("POST", "/convert", bytes.NewBufferString("<p>Hello, World!</p>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "text/html")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+	}
+
+	var response ConvertResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+	if !contains(response.Markdown, "Hello, World!") {
+		t.Errorf("expected markdown to contain %q, got %q", "Hello, World!", response.Markdown)
+	}
+}
+
+func TestConvertHTML_AcceptMarkdown(t *testing.T) {
+	converter := NewConverter()
+	handler := NewHandler(converter)
+
+	router := mux.NewRouter()
+	handler.RegisterRoutes(router)
+
+	jsonBody, _ := json.Marshal(ConvertRequest{HTML: "<p>Hello, World!</p>"})
+	req, err := http.NewRequest("POST", "/convert", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "text/markdown")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+	}
+	if ct := rr.Header().Get("Content-Type"); !contains(ct, "text/markdown") {
+		t.Errorf("expected Content-Type text/markdown, got %q", ct)
+	}
+	if !contains(rr.Body.String(), "Hello, World!") {
+		t.Errorf("expected raw markdown body, got %q", rr.Body.String())
+	}
+	// No JSON envelope should wrap the body.
+	var anyJSON map[string]interface{}
+	if err := json.Unmarshal(rr.Body.Bytes(), &anyJSON); err == nil {
+		t.Errorf("expected raw markdown, got JSON envelope: %q", rr.Body.String())
+	}
+}
+
+func TestConvertHTML_AcceptHTML(t *testing.T) {
+	converter := NewConverter()
+	handler := NewHandler(converter)
+
+	router := mux.NewRouter()
+	handler.RegisterRoutes(router)
+
+	jsonBody, _ := json.Marshal(ConvertRequest{HTML: "<p>Hello, <strong>World</strong>!</p>"})
+	req, err := http.NewRequest("POST", "/convert", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "text/html")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+	}
+	if ct := rr.Header().Get("Content-Type"); !contains(ct, "text/html") {
+		t.Errorf("expected Content-Type text/html, got %q", ct)
+	}
+	if !contains(rr.Body.String(), "<strong>World</strong>") {
+		t.Errorf("expected round-tripped HTML, got %q", rr.Body.String())
+	}
+}
+
+func TestConvertBatch_AcceptNDJSON(t *testing.T) {
+	converter := NewConverter()
+	handler := NewHandler(converter)
+
+	router := mux.NewRouter()
+	handler.RegisterRoutes(router)
+
+	jsonBody, _ := json.Marshal(BatchConvertRequest{
+		Items: []BatchConvertItem{{ID: "a", HTML: "<p>Hello</p>"}, {ID: "b", HTML: "<p>World</p>"}},
+	})
+	req, err := http.NewRequest("POST", "/convert/batch", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/x-ndjson")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+	}
+	if ct := rr.Header().Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Errorf("expected Content-Type application/x-ndjson, got %q", ct)
+	}
+
+	lines := bytes.Split(bytes.TrimSpace(rr.Body.Bytes()), []byte("\n"))
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 ndjson lines, got %d", len(lines))
+	}
+	for _, line := range lines {
+		var item BatchConvertResultItem
+		if err := json.Unmarshal(line, &item); err != nil {
+			t.Errorf("failed to parse ndjson line %q: %v", line, err)
+		}
+	}
+}
+
+func TestConvertHTML_WithOptions(t *testing.T) {
+	converter := NewConverter()
+	handler := NewHandler(converter)
+
+	router := mux.NewRouter()
+	handler.RegisterRoutes(router)
+
+	reqBody := ConvertRequest{
+		HTML: `<h1>Title</h1><img src="pic.png" alt="a cat"><script>evil()</script>`,
+		Options: RequestOptions{
+			HeadingStyle:   "setext",
+			ImageAsAltText: true,
+			StripTags:      []string{"script"},
+		},
+	}
+	jsonBody, _ := json.Marshal(reqBody)
+
+	req, err := http.NewRequest("POST", "/convert", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+	}
+
+	var response ConvertResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to parse response: %v", err)
+	}
+
+	if !contains(response.Markdown, "Title\n=====") {
+		t.Errorf("expected setext heading style, got %q", response.Markdown)
+	}
+	if !contains(response.Markdown, "a cat") {
+		t.Errorf("expected image alt text in output, got %q", response.Markdown)
+	}
+	if contains(response.Markdown, "evil()") {
+		t.Errorf("expected stripped <script> content to be removed, got %q", response.Markdown)
+	}
+}
+
+func TestConvertBatch_Success(t *testing.T) {
+	converter := NewConverter()
+	handler := NewHandler(converter)
+
+	router := mux.NewRouter()
+	handler.RegisterRoutes(router)
+
+	reqBody := BatchConvertRequest{
+		Items: []BatchConvertItem{
+			{ID: "a", HTML: "<p>Hello</p>"},
+			{ID: "b", HTML: "<p>World</p>"},
+		},
+	}
+	jsonBody, _ := json.Marshal(reqBody)
+
+	req, err := http.NewRequest("POST", "/convert/batch", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Errorf("handler returned wrong status code: got %v want %v",
+			status, http.StatusOK)
+	}
+
+	var response []BatchConvertResultItem
+	if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+		t.Errorf("failed to parse response: %v", err)
+	}
+
+	if len(response) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(response))
+	}
+
+	if response[0].ID != "a" || !response[0].Success || !contains(response[0].Markdown, "Hello") {
+		t.Errorf("unexpected result for item a: %+v", response[0])
+	}
+	if response[1].ID != "b" || !response[1].Success || !contains(response[1].Markdown, "World") {
+		t.Errorf("unexpected result for item b: %+v", response[1])
+	}
+}
+
+func TestConvertBatch_EmptyItems(t *testing.T) {
+	converter := NewConverter()
+	handler := NewHandler(converter)
+
+	router := mux.NewRouter()
+	handler.RegisterRoutes(router)
+
+	jsonBody, _ := json.Marshal(BatchConvertRequest{Items: []BatchConvertItem{}})
+
+	req, err := http.NewRequest("POST", "/convert/batch", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusBadRequest {
+		t.Errorf("handler returned wrong status code: got %v want %v",
+			status, http.StatusBadRequest)
+	}
+}
+
+func TestConvertHTMLStream_Success(t *testing.T) {
+	converter := NewConverter()
+	handler := NewHandler(converter)
+
+	router := mux.NewRouter()
+	handler.RegisterRoutes(router)
+
+	body := "<h1>Title</h1><p>Hello, <strong>World</strong>!</p><ul><li>Item 1</li></ul>"
+
+	req, err := http.NewRequest("POST", "/convert/stream", bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "text/html")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Errorf("handler returned wrong status code: got %v want %v",
+			status, http.StatusOK)
+	}
+
+	out := rr.Body.String()
+	for _, elem := range []string{"Title", "Hello", "**World**", "Item 1"} {
+		if !contains(out, elem) {
+			t.Errorf("expected streamed markdown to contain %q, got %q", elem, out)
+		}
+	}
+
+	if rr.Header().Get("X-Conversion-Error") != "" {
+		t.Errorf("expected no conversion error trailer, got %q", rr.Header().Get("X-Conversion-Error"))
+	}
+}
+
 // Helper function to check if a string contains a substring
 func contains(s, substr string) bool {
 	return bytes.Contains([]byte(s), []byte(substr))
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -11,10 +12,14 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+
+	pb "github.com/firecrawl/go-html-to-md-service/proto"
 )
 
 const (
 	defaultPort            = "8080"
+	defaultGRPCPort        = "8081"
 	defaultShutdownTimeout = 30 * time.Second
 	defaultReadTimeout     = 1 * time.Minute
 	defaultWriteTimeout    = 1 * time.Minute
@@ -68,7 +73,17 @@ func main() {
 		WriteTimeout: defaultWriteTimeout,
 	}
 
-	// Start server in goroutine
+	// Get the gRPC port from environment or use default. It shares the same
+	// Converter instance as the HTTP handler.
+	grpcPort := os.Getenv("GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = defaultGRPCPort
+	}
+
+	grpcSrv := grpc.NewServer()
+	pb.RegisterConvertServiceServer(grpcSrv, newGRPCServer(converter))
+
+	// Start servers in goroutines
 	go func() {
 		log.Info().
 			Str("port", port).
@@ -80,7 +95,20 @@ func main() {
 		}
 	}()
 
-	// Wait for interrupt signal to gracefully shutdown the server
+	go func() {
+		lis, err := net.Listen("tcp", ":"+grpcPort)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to listen on gRPC port")
+		}
+
+		log.Info().Str("port", grpcPort).Msg("Starting gRPC service")
+
+		if err := grpcSrv.Serve(lis); err != nil && err != grpc.ErrServerStopped {
+			log.Fatal().Err(err).Msg("Failed to start gRPC server")
+		}
+	}()
+
+	// Wait for interrupt signal to gracefully shutdown the servers
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
@@ -91,6 +119,23 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), defaultShutdownTimeout)
 	defer cancel()
 
+	// GracefulStop waits for in-flight RPCs (including long-lived streams
+	// like ConvertStream) to finish and has no timeout of its own, so race
+	// it against the same deadline as the HTTP shutdown and fall back to
+	// the hard Stop if it's still blocked when that expires.
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcSrv.GracefulStop()
+		close(grpcStopped)
+	}()
+
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		log.Warn().Msg("gRPC server did not shut down gracefully in time, forcing stop")
+		grpcSrv.Stop()
+	}
+
 	// Attempt graceful shutdown
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Fatal().Err(err).Msg("Server forced to shutdown")
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	convertRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "convert_requests_total",
+		Help: "Total number of HTML to Markdown conversion requests, labeled by outcome status.",
+	}, []string{"status"})
+
+	convertDurationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "convert_duration_seconds",
+		Help:    "Time spent converting a single HTML document to Markdown.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"status"})
+
+	convertInputBytes = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "convert_input_bytes",
+		Help:    "Size in bytes of the HTML input to a conversion request.",
+		Buckets: prometheus.ExponentialBuckets(256, 4, 10),
+	})
+
+	convertOutputBytes = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "convert_output_bytes",
+		Help:    "Size in bytes of the Markdown output of a conversion request.",
+		Buckets: prometheus.ExponentialBuckets(256, 4, 10),
+	})
+
+	convertInflight = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "convert_inflight",
+		Help: "Number of HTML to Markdown conversion requests currently being processed.",
+	})
+)
+
+// metricsHandler serves the Prometheus exposition format for /metrics.
+func metricsHandler() http.Handler {
+	return promhttp.Handler()
+}
+
+// observeConversion records the standard conversion metrics for a single
+// request. status is "success" or "error".
+func observeConversion(status string, duration time.Duration, inputSize, outputSize int) {
+	convertRequestsTotal.WithLabelValues(status).Inc()
+	convertDurationSeconds.WithLabelValues(status).Observe(duration.Seconds())
+	convertInputBytes.Observe(float64(inputSize))
+	convertOutputBytes.Observe(float64(outputSize))
+}
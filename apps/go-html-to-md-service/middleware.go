@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/html"
+	"golang.org/x/time/rate"
+)
+
+// loggerFromRequest builds a logger tagged with the request's X-Request-ID
+// and, once tracingMiddleware has run, its trace/span IDs, so conversion
+// logs can be correlated with traces.
+func loggerFromRequest(r *http.Request) zerolog.Logger {
+	logger := log.Logger
+
+	if requestID := r.Header.Get("X-Request-ID"); requestID != "" {
+		logger = logger.With().Str("request_id", requestID).Logger()
+	}
+
+	if spanCtx := trace.SpanContextFromContext(r.Context()); spanCtx.IsValid() {
+		logger = logger.With().
+			Str("trace_id", spanCtx.TraceID().String()).
+			Str("span_id", spanCtx.SpanID().String()).
+			Logger()
+	}
+
+	return logger
+}
+
+// countDOMNodes parses htmlStr and returns the total number of nodes in its
+// DOM tree, a rough proxy for document complexity.
+func countDOMNodes(htmlStr string) int {
+	doc, err := html.Parse(strings.NewReader(htmlStr))
+	if err != nil {
+		return 0
+	}
+
+	count := 0
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		count++
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	return count
+}
+
+var codeFenceLangRegex = regexp.MustCompile("(?m)^```([A-Za-z0-9_+-]+)")
+
+// detectCodeLanguages returns the distinct fenced code-block languages
+// present in markdown, in order of first appearance.
+func detectCodeLanguages(markdown string) []string {
+	seen := make(map[string]bool)
+	var langs []string
+	for _, match := range codeFenceLangRegex.FindAllStringSubmatch(markdown, -1) {
+		lang := match[1]
+		if !seen[lang] {
+			seen[lang] = true
+			langs = append(langs, lang)
+		}
+	}
+	return langs
+}
+
+// tracer is used to start a span around each request and, separately, a
+// child span around each conversion (see handler.go).
+var tracer = otel.Tracer("github.com/firecrawl/go-html-to-md-service")
+
+// recordConversion emits the span and Prometheus metrics shared by all
+// three conversion entry points (ConvertHTML, ConvertBatch per item, and
+// ConvertHTMLStream), covering [start, start+duration), so none of them is
+// invisible to the dashboards this instrumentation exists for.
+//
+// domNodeCount is 0 when unknown (ConvertHTMLStream never buffers the full
+// document, so it can't compute one), in which case the attribute is
+// omitted rather than reported as a misleading zero. markdownForLangs is
+// used only to detect fenced code-block languages for the span and may be
+// empty for the same reason.
+func recordConversion(ctx context.Context, spanName string, inputSize, domNodeCount int, start time.Time, duration time.Duration, markdownForLangs string, outputSize int, convertErr error) {
+	_, span := tracer.Start(ctx, spanName, trace.WithTimestamp(start))
+	span.SetAttributes(attribute.Int("input.size_bytes", inputSize))
+	if domNodeCount > 0 {
+		span.SetAttributes(attribute.Int("dom.node_count", domNodeCount))
+	}
+
+	if convertErr != nil {
+		span.SetAttributes(attribute.Bool("error", true))
+		span.End(trace.WithTimestamp(start.Add(duration)))
+		observeConversion("error", duration, inputSize, 0)
+		return
+	}
+
+	if langs := detectCodeLanguages(markdownForLangs); len(langs) > 0 {
+		span.SetAttributes(attribute.StringSlice("code.languages", langs))
+	}
+	span.End(trace.WithTimestamp(start.Add(duration)))
+	observeConversion("success", duration, inputSize, outputSize)
+}
+
+// requestIDMiddleware injects a generated X-Request-ID header when the
+// caller didn't send one, and echoes it back on the response so clients can
+// correlate logs without having to mint their own IDs.
+func requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Request-ID") == "" {
+			r.Header.Set("X-Request-ID", generateRequestID())
+		}
+		w.Header().Set("X-Request-ID", r.Header.Get("X-Request-ID"))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// generateRequestID returns a random 16-byte hex-encoded identifier.
+func generateRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b[:])
+}
+
+// tracingMiddleware starts an OpenTelemetry span covering the whole request,
+// tagged with the HTTP method and path.
+func tracingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracer.Start(r.Context(), r.Method+" "+r.URL.Path)
+		defer span.End()
+
+		span.SetAttributes(
+			attribute.String("http.method", r.Method),
+			attribute.String("http.target", r.URL.Path),
+		)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// limiterIdleTTL is how long a per-IP limiter is kept after its last request
+// before ipRateLimiter.sweep evicts it. Configurable via
+// RATE_LIMIT_IDLE_MINUTES (default 10) for environments with unusually long
+// gaps between requests from the same crawler IP.
+const defaultLimiterIdleMinutes = 10
+
+// ipRateLimiter enforces a per-client-IP token-bucket rate limit, sized via
+// the RATE_LIMIT_RPS (requests/sec, default 20) and RATE_LIMIT_BURST
+// (default 40) environment variables. Limiters idle past limiterIdleTTL are
+// evicted by a background sweep so a fleet with many distinct client IPs
+// doesn't grow the map without bound.
+type ipRateLimiter struct {
+	mu       sync.Mutex
+	limiters map[string]*limiterEntry
+	rps      rate.Limit
+	burst    int
+	idleTTL  time.Duration
+}
+
+// limiterEntry pairs a client IP's token bucket with the time it was last
+// used, so sweep can tell idle entries from active ones.
+type limiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newIPRateLimiter() *ipRateLimiter {
+	l := &ipRateLimiter{
+		limiters: make(map[string]*limiterEntry),
+		rps:      rate.Limit(envFloat("RATE_LIMIT_RPS", 20)),
+		burst:    envInt("RATE_LIMIT_BURST", 40),
+		idleTTL:  time.Duration(envInt("RATE_LIMIT_IDLE_MINUTES", defaultLimiterIdleMinutes)) * time.Minute,
+	}
+	go l.sweepLoop()
+	return l
+}
+
+func (l *ipRateLimiter) allow(ip string) bool {
+	l.mu.Lock()
+	entry, ok := l.limiters[ip]
+	if !ok {
+		entry = &limiterEntry{limiter: rate.NewLimiter(l.rps, l.burst)}
+		l.limiters[ip] = entry
+	}
+	entry.lastSeen = time.Now()
+	lim := entry.limiter
+	l.mu.Unlock()
+
+	return lim.Allow()
+}
+
+// sweepLoop periodically evicts limiters idle past idleTTL. It runs for the
+// lifetime of the process; ipRateLimiter instances are expected to live as
+// long as the Handler that owns them.
+func (l *ipRateLimiter) sweepLoop() {
+	ticker := time.NewTicker(l.idleTTL)
+	defer ticker.Stop()
+	for range ticker.C {
+		l.sweep()
+	}
+}
+
+// sweep removes limiters that haven't been used in the last idleTTL.
+func (l *ipRateLimiter) sweep() {
+	cutoff := time.Now().Add(-l.idleTTL)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for ip, entry := range l.limiters {
+		if entry.lastSeen.Before(cutoff) {
+			delete(l.limiters, ip)
+		}
+	}
+}
+
+// rateLimitMiddleware rejects requests over the per-IP rate limit with 429.
+func rateLimitMiddleware(limiter *ipRateLimiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !limiter.allow(clientIP(r)) {
+				http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientIP extracts the request's IP address, stripping the port from
+// RemoteAddr when present.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func envFloat(key string, fallback float64) float64 {
+	if v := os.Getenv(key); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil && f > 0 {
+			return f
+		}
+	}
+	return fallback
+}
+
+func envInt(key string, fallback int) int {
+	if v := os.Getenv(key); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIPRateLimiter_SweepEvictsIdleEntries(t *testing.T) {
+	limiter := &ipRateLimiter{
+		limiters: make(map[string]*limiterEntry),
+		rps:      10,
+		burst:    10,
+		idleTTL:  time.Minute,
+	}
+
+	limiter.allow("1.2.3.4")
+	limiter.allow("5.6.7.8")
+
+	// Backdate one entry past idleTTL and leave the other fresh.
+	limiter.mu.Lock()
+	limiter.limiters["1.2.3.4"].lastSeen = time.Now().Add(-2 * time.Minute)
+	limiter.mu.Unlock()
+
+	limiter.sweep()
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	if _, ok := limiter.limiters["1.2.3.4"]; ok {
+		t.Errorf("expected idle limiter to be evicted")
+	}
+	if _, ok := limiter.limiters["5.6.7.8"]; !ok {
+		t.Errorf("expected active limiter to be kept")
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ModeFull disables any pre-processing and converts the document as-is.
+// ModeSanitized strips non-content chrome (nav/aside/footer/script/style and
+// hidden elements) but keeps the rest of the body. ModeReadability goes
+// further and also picks out the single highest-scoring subtree, so only the
+// article body is converted.
+const (
+	ModeFull        = "full"
+	ModeSanitized   = "sanitized"
+	ModeReadability = "readability"
+)
+
+var (
+	boostClassRegex    = regexp.MustCompile(`(?i)article|content|post|entry`)
+	penalizeClassRegex = regexp.MustCompile(`(?i)comment|sidebar|nav|footer|ad`)
+
+	// contentCandidateSelector lists the tags eligible to be the extracted
+	// main-content subtree.
+	contentCandidateSelector = "div, article, section, main"
+)
+
+// preprocessHTML runs the sanitizer and, for ModeReadability, the
+// main-content extractor over htmlStr before it reaches the markdown
+// converter. ModeFull (or an unrecognized mode) returns htmlStr unchanged.
+func preprocessHTML(htmlStr string, mode string) (string, error) {
+	if mode != ModeSanitized && mode != ModeReadability {
+		return htmlStr, nil
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlStr))
+	if err != nil {
+		return "", err
+	}
+
+	stripNonContentElements(doc.Selection)
+
+	target := doc.Find("body")
+	if target.Length() == 0 {
+		target = doc.Selection
+	}
+	if mode == ModeReadability {
+		if main := extractMainContent(doc); main != nil {
+			target = main
+		}
+	}
+
+	return target.Html()
+}
+
+// stripNonContentElements removes nav/aside/footer/script/style/noscript
+// elements and anything hidden via the `hidden` attribute or inline
+// `display: none`, in place.
+func stripNonContentElements(sel *goquery.Selection) {
+	sel.Find("nav, aside, footer, script, style, noscript").Remove()
+	sel.Find("[hidden]").Remove()
+	sel.Find("[style]").Each(func(_ int, s *goquery.Selection) {
+		style := strings.ToLower(strings.ReplaceAll(s.AttrOr("style", ""), " ", ""))
+		if strings.Contains(style, "display:none") {
+			s.Remove()
+		}
+	})
+}
+
+// scoreNode implements the Readability-style density heuristic: text length
+// minus the text length contributed by links, boosted for
+// article/content/post/entry class or id hints and penalized for
+// comment/sidebar/nav/footer/ad hints.
+func scoreNode(s *goquery.Selection) float64 {
+	text := s.Text()
+	score := float64(len(text))
+
+	s.Find("a").Each(func(_ int, a *goquery.Selection) {
+		score -= float64(len(a.Text()))
+	})
+
+	hints := s.AttrOr("class", "") + " " + s.AttrOr("id", "")
+	if boostClassRegex.MatchString(hints) {
+		score *= 1.5
+	}
+	if penalizeClassRegex.MatchString(hints) {
+		score *= 0.2
+	}
+
+	return score
+}
+
+// extractMainContent scores every candidate block element in doc and
+// returns the highest-scoring subtree, walking up to ancestors as long as
+// the parent's score stays within 20% of the child's (parentScore >=
+// 0.8*childScore), so a wrapper div around the real article isn't left
+// behind. Returns nil if doc has no candidates at all.
+func extractMainContent(doc *goquery.Document) *goquery.Selection {
+	var best *goquery.Selection
+	bestScore := 0.0
+
+	doc.Find(contentCandidateSelector).Each(func(_ int, s *goquery.Selection) {
+		score := scoreNode(s)
+		if best == nil || score > bestScore {
+			best = s
+			bestScore = score
+		}
+	})
+
+	if best == nil {
+		return nil
+	}
+
+	current, currentScore := best, bestScore
+	for {
+		parent := current.Parent()
+		if parent.Length() == 0 || goquery.NodeName(parent) == "body" || goquery.NodeName(parent) == "html" {
+			break
+		}
+		parentScore := scoreNode(parent)
+		if parentScore < 0.8*currentScore {
+			break
+		}
+		current, currentScore = parent, parentScore
+	}
+
+	return current
+}
@@ -0,0 +1,50 @@
+package main
+
+import "testing"
+
+func TestPreprocessHTML_Sanitized(t *testing.T) {
+	html := `<body><nav>Nav</nav><article><p>Real content</p></article><footer>Footer</footer></body>`
+
+	out, err := preprocessHTML(html, ModeSanitized)
+	if err != nil {
+		t.Fatalf("preprocessHTML failed: %v", err)
+	}
+
+	if !contains(out, "Real content") {
+		t.Errorf("expected sanitized output to keep article content, got %q", out)
+	}
+	if contains(out, "Nav") || contains(out, "Footer") {
+		t.Errorf("expected sanitized output to drop nav/footer, got %q", out)
+	}
+}
+
+func TestPreprocessHTML_Readability(t *testing.T) {
+	html := `<body>
+		<div class="sidebar"><a href="#">Link 1</a><a href="#">Link 2</a></div>
+		<article class="post-content"><p>This is the real article body with plenty of text to win the scoring contest.</p></article>
+	</body>`
+
+	out, err := preprocessHTML(html, ModeReadability)
+	if err != nil {
+		t.Fatalf("preprocessHTML failed: %v", err)
+	}
+
+	if !contains(out, "real article body") {
+		t.Errorf("expected readability output to contain the article body, got %q", out)
+	}
+	if contains(out, "Link 1") {
+		t.Errorf("expected readability output to drop the low-scoring sidebar, got %q", out)
+	}
+}
+
+func TestPreprocessHTML_Full(t *testing.T) {
+	html := `<body><nav>Nav</nav><p>Content</p></body>`
+
+	out, err := preprocessHTML(html, ModeFull)
+	if err != nil {
+		t.Fatalf("preprocessHTML failed: %v", err)
+	}
+	if !contains(out, "Nav") {
+		t.Errorf("expected ModeFull to leave document untouched, got %q", out)
+	}
+}